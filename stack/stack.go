@@ -5,6 +5,13 @@ type Stack[T any] interface {
 	Pop() (T, bool)
 	Push(T)
 	Peek() (T, bool)
+	Dup() bool
+	RemoveAt(int) (T, bool)
+	With(...T) Stack[T]
+	CopyInto([]T) int
+	Split(depth int) (bottom, top Stack[T])
+	PeekOr(T) T
+	PopOr(T) T
 	Len() int
 	Cap() int
 }
@@ -47,6 +54,78 @@ func (s *stack[T]) Peek() (T, bool) {
 	return (*s)[len(*s)-1], true
 }
 
+// Dup pushes a copy of the top element onto the stack.
+// If the stack is empty, it does nothing and returns false.
+// For reference types only the reference is duplicated, not the value it
+// points to.
+func (s *stack[T]) Dup() bool {
+	if len(*s) == 0 {
+		return false
+	}
+	*s = append(*s, (*s)[len(*s)-1])
+	return true
+}
+
+// RemoveAt removes and returns the element at index i, counted from the top
+// of the stack (0 is the top element). The elements below it keep their
+// relative order. It runs in O(n).
+// If i is out of range, it returns the zero value of type T and false.
+func (s *stack[T]) RemoveAt(i int) (T, bool) {
+	if i < 0 || i >= len(*s) {
+		return *new(T), false
+	}
+	j := len(*s) - 1 - i
+	t := (*s)[j]
+	copy((*s)[j:], (*s)[j+1:])
+	*s = (*s)[:len(*s)-1]
+	return t, true
+}
+
+// With pushes the given elements onto the stack in order and returns the
+// stack itself, allowing chained construction such as New[int]().With(1, 2, 3).
+func (s *stack[T]) With(items ...T) Stack[T] {
+	*s = append(*s, items...)
+	return s
+}
+
+// CopyInto copies the elements of the stack into dst, from bottom to top,
+// without allocating. It copies at most len(dst) elements, truncating the
+// topmost ones if dst is too small, and returns the number copied.
+func (s *stack[T]) CopyInto(dst []T) int {
+	return copy(dst, *s)
+}
+
+// Split cuts the stack in two at the given depth, counted from the bottom.
+// The bottom stack holds the elements at positions [0, depth) and the top
+// stack holds the rest, both keeping their order. A depth outside
+// [0, Len()] is clamped to that range.
+// Split consumes the receiver, which is left empty.
+func (s *stack[T]) Split(depth int) (bottom, top Stack[T]) {
+	depth = max(0, min(depth, len(*s)))
+	b := (*s)[:depth:depth]
+	t := (*s)[depth:]
+	*s = nil
+	return &b, &t
+}
+
+// PeekOr returns the top element of the stack without removing it.
+// If the stack is empty, it returns def.
+func (s *stack[T]) PeekOr(def T) T {
+	if t, ok := s.Peek(); ok {
+		return t
+	}
+	return def
+}
+
+// PopOr removes and returns the top element of the stack.
+// If the stack is empty, it returns def.
+func (s *stack[T]) PopOr(def T) T {
+	if t, ok := s.Pop(); ok {
+		return t
+	}
+	return def
+}
+
 // New creates a new stack.
 // If one argument is provided, it creates a stack with the specified capacity.
 // If no arguments are provided, it creates a stack with the default capacity.
@@ -67,3 +146,28 @@ func New[T any](capacity ...int) Stack[T] {
 		return &s
 	}
 }
+
+// EqualUnordered reports whether a and b hold the same elements regardless
+// of their order, comparing them as multisets of the keys derived by key.
+func EqualUnordered[T any, K comparable](a, b Stack[T], key func(T) K) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	as := make([]T, a.Len())
+	a.CopyInto(as)
+	bs := make([]T, b.Len())
+	b.CopyInto(bs)
+
+	counts := make(map[K]int, len(as))
+	for _, t := range as {
+		counts[key(t)]++
+	}
+	for _, t := range bs {
+		k := key(t)
+		if counts[k] == 0 {
+			return false
+		}
+		counts[k]--
+	}
+	return true
+}
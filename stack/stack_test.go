@@ -113,4 +113,237 @@ func TestStack(t *testing.T) {
 			assert.Zero(t, s.Len(), "The stack should have 0 elements")
 		},
 	)
+	t.Run(
+		"A stack should duplicate the top element when using Dup()",
+		func(t *testing.T) {
+			s := New[int]()
+			s.Push(1)
+			s.Push(2)
+			assert.True(t, s.Dup(), "Dup() should succeed on a non-empty stack")
+			assert.Equal(t, 3, s.Len(), "The stack should have 3 elements")
+			v, _ := s.Pop()
+			assert.Equal(t, 2, v, "The top element should be 2")
+			v, _ = s.Pop()
+			assert.Equal(t, 2, v, "The second element should be 2")
+		},
+	)
+
+	t.Run(
+		"A stack should return false when trying to Dup() an empty stack",
+		func(t *testing.T) {
+			s := New[int]()
+			assert.False(t, s.Dup(), "Dup() should fail on an empty stack")
+			assert.Zero(t, s.Len(), "The stack should have 0 elements")
+		},
+	)
+
+	t.Run(
+		"A stack should remove an element by index from the top when using RemoveAt()",
+		func(t *testing.T) {
+			s := New[int]()
+			s.Push(1)
+			s.Push(2)
+			s.Push(3)
+			s.Push(4)
+			v, ok := s.RemoveAt(1)
+			assert.True(t, ok, "The element should exist")
+			assert.Equal(t, 3, v, "The removed element should be 3")
+			assert.Equal(t, 3, s.Len(), "The stack should have 3 elements")
+			for _, want := range []int{4, 2, 1} {
+				v, _ = s.Pop()
+				assert.Equal(
+					t,
+					want,
+					v,
+					"The remaining elements should keep their order",
+				)
+			}
+		},
+	)
+
+	t.Run(
+		"A stack should return false when using RemoveAt() with an out of range index",
+		func(t *testing.T) {
+			s := New[int]()
+			s.Push(1)
+			v, ok := s.RemoveAt(1)
+			assert.False(t, ok, "The element should not exist")
+			assert.Zero(t, v, "The returned element should be zero")
+			v, ok = s.RemoveAt(-1)
+			assert.False(t, ok, "The element should not exist")
+			assert.Zero(t, v, "The returned element should be zero")
+			assert.Equal(t, 1, s.Len(), "The stack should have 1 element")
+		},
+	)
+
+	t.Run(
+		"A stack should push all elements in order and return itself when using With()",
+		func(t *testing.T) {
+			s := New[int]().With(1, 2, 3)
+			assert.Equal(t, 3, s.Len(), "The stack should have 3 elements")
+			for _, want := range []int{3, 2, 1} {
+				v, ok := s.Pop()
+				assert.True(t, ok, "The top element should exist")
+				assert.Equal(
+					t,
+					want,
+					v,
+					"Elements should pop in reverse push order",
+				)
+			}
+		},
+	)
+
+	t.Run(
+		"A stack should copy its elements bottom to top when using CopyInto()",
+		func(t *testing.T) {
+			s := New[int]().With(1, 2, 3)
+
+			exact := make([]int, 3)
+			assert.Equal(t, 3, s.CopyInto(exact), "Should copy 3 elements")
+			assert.Equal(t, []int{1, 2, 3}, exact, "Should copy all elements")
+
+			small := make([]int, 2)
+			assert.Equal(t, 2, s.CopyInto(small), "Should copy 2 elements")
+			assert.Equal(
+				t,
+				[]int{1, 2},
+				small,
+				"Should copy the bottom elements",
+			)
+
+			large := make([]int, 5)
+			assert.Equal(t, 3, s.CopyInto(large), "Should copy 3 elements")
+			assert.Equal(
+				t,
+				[]int{1, 2, 3, 0, 0},
+				large,
+				"Should leave the rest untouched",
+			)
+
+			assert.Equal(
+				t,
+				3,
+				s.Len(),
+				"The stack should still have 3 elements",
+			)
+		},
+	)
+
+	t.Run(
+		"A stack should be cut in two at the given depth when using Split()",
+		func(t *testing.T) {
+			s := New[int]().With(1, 2, 3, 4)
+			bottom, top := s.Split(2)
+			assert.Zero(t, s.Len(), "The original stack should be empty")
+			for _, want := range []int{2, 1} {
+				v, _ := bottom.Pop()
+				assert.Equal(t, want, v, "The bottom stack should hold 1 and 2")
+			}
+			for _, want := range []int{4, 3} {
+				v, _ := top.Pop()
+				assert.Equal(t, want, v, "The top stack should hold 3 and 4")
+			}
+		},
+	)
+
+	t.Run(
+		"Stacks created using Split() should be independent",
+		func(t *testing.T) {
+			bottom, top := New[int]().With(1, 2, 3, 4).Split(2)
+			bottom.Push(5)
+			v, _ := top.Peek()
+			assert.Equal(t, 4, v, "Pushing onto bottom should not affect top")
+			assert.Equal(
+				t,
+				2,
+				top.Len(),
+				"The top stack should have 2 elements",
+			)
+		},
+	)
+
+	t.Run(
+		"A stack should clamp an out of range depth when using Split()",
+		func(t *testing.T) {
+			bottom, top := New[int]().With(1, 2).Split(-1)
+			assert.Zero(t, bottom.Len(), "The bottom stack should be empty")
+			assert.Equal(
+				t,
+				2,
+				top.Len(),
+				"The top stack should have 2 elements",
+			)
+
+			bottom, top = New[int]().With(1, 2).Split(5)
+			assert.Equal(
+				t,
+				2,
+				bottom.Len(),
+				"The bottom stack should have 2 elements",
+			)
+			assert.Zero(t, top.Len(), "The top stack should be empty")
+		},
+	)
+
+	t.Run(
+		"A stack should return the top element or the default when using PeekOr()",
+		func(t *testing.T) {
+			s := New[int]()
+			assert.Equal(
+				t,
+				-1,
+				s.PeekOr(-1),
+				"An empty stack should return the default",
+			)
+			s.Push(1)
+			assert.Equal(t, 1, s.PeekOr(-1), "The top element should be 1")
+			assert.Equal(t, 1, s.Len(), "The stack should have 1 element")
+		},
+	)
+
+	t.Run(
+		"A stack should remove the top element or return the default when using PopOr()",
+		func(t *testing.T) {
+			s := New[int]()
+			assert.Equal(
+				t,
+				-1,
+				s.PopOr(-1),
+				"An empty stack should return the default",
+			)
+			s.Push(1)
+			assert.Equal(t, 1, s.PopOr(-1), "The top element should be 1")
+			assert.Zero(t, s.Len(), "The stack should have 0 elements")
+		},
+	)
+
+	t.Run(
+		"Stacks with the same elements in any order should be equal using EqualUnordered()",
+		func(t *testing.T) {
+			id := func(v int) int { return v }
+			a := New[int]().With(1, 2, 2)
+			assert.True(
+				t,
+				EqualUnordered(a, New[int]().With(2, 1, 2), id),
+				"[1,2,2] and [2,1,2] should be equal",
+			)
+			assert.False(
+				t,
+				EqualUnordered(a, New[int]().With(1, 1, 2), id),
+				"[1,2,2] and [1,1,2] should not be equal",
+			)
+			assert.False(
+				t,
+				EqualUnordered(a, New[int]().With(1, 2), id),
+				"Stacks of different lengths should not be equal",
+			)
+			assert.Equal(
+				t,
+				3,
+				a.Len(),
+				"The stack should still have 3 elements",
+			)
+		},
+	)
 }